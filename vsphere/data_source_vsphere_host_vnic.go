@@ -0,0 +1,157 @@
+package vsphere
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceVSphereHostVNic() *schema.Resource {
+	return &schema.Resource{
+		Read:   dataSourceVSphereHostVNicRead,
+		Schema: hostVNicDataSourceSchema(),
+	}
+}
+
+// hostVNicDataSourceSchema builds a read-only version of BaseVMKernelSchema so
+// the data source exposes the same attributes as the vsphere_vnic resource.
+func hostVNicDataSourceSchema() map[string]*schema.Schema {
+	sch := datasourceSchemaFromResourceSchema(BaseVMKernelSchema())
+
+	sch["host"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "ESX host the interface belongs to",
+	}
+	sch["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The device name of the vmkernel interface, e.g. vmk0",
+	}
+	sch["key"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The internal key of the vmkernel interface on the host",
+	}
+
+	return sch
+}
+
+// datasourceSchemaFromResourceSchema turns a resource schema into one
+// suitable for a data source: every attribute becomes Computed and loses the
+// resource-only traits (ForceNew, Default, ConflictsWith) that don't apply to
+// a read-only lookup.
+func datasourceSchemaFromResourceSchema(rs map[string]*schema.Schema) map[string]*schema.Schema {
+	ds := make(map[string]*schema.Schema, len(rs))
+	for k, v := range rs {
+		cp := *v
+		cp.Required = false
+		cp.Optional = false
+		cp.Computed = true
+		cp.ForceNew = false
+		cp.Default = nil
+		cp.ConflictsWith = nil
+		cp.DiffSuppressFunc = nil
+		cp.ValidateFunc = nil
+		if cp.Elem != nil {
+			if res, ok := cp.Elem.(*schema.Resource); ok {
+				cp.Elem = &schema.Resource{Schema: datasourceSchemaFromResourceSchema(res.Schema)}
+			}
+		}
+		ds[k] = &cp
+	}
+	return ds
+}
+
+func dataSourceVSphereHostVNicRead(d *schema.ResourceData, meta interface{}) error {
+	ctx := context.TODO()
+	client := meta.(*VSphereClient).vimClient
+
+	hostId := d.Get("host").(string)
+	nicId := d.Get("name").(string)
+
+	vnic, err := getVnicFromHost(ctx, client, hostId, nicId)
+	if err != nil {
+		return fmt.Errorf("error fetching vnic %s from host %s: %s", nicId, hostId, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s_%s", hostId, nicId))
+	_ = d.Set("key", vnic.Key)
+	_ = d.Set("netstack", vnic.Spec.NetStackInstanceKey)
+	_ = d.Set("portgroup", vnic.Portgroup)
+	if vnic.Spec.DistributedVirtualPort != nil {
+		_ = d.Set("distributed_switch_port", vnic.Spec.DistributedVirtualPort.SwitchUuid)
+		_ = d.Set("distributed_port_group", vnic.Spec.DistributedVirtualPort.PortgroupKey)
+	}
+	_ = d.Set("mtu", vnic.Spec.Mtu)
+	_ = d.Set("mac", vnic.Spec.Mac)
+
+	ipFamily := ipFamilyDual
+	switch {
+	case vnic.Spec.Ip.IpAddress != "" && vnic.Spec.Ip.IpV6Config == nil:
+		ipFamily = ipFamilyIPv4
+	case vnic.Spec.Ip.IpAddress == "" && vnic.Spec.Ip.IpV6Config != nil:
+		ipFamily = ipFamilyIPv6
+	}
+	_ = d.Set("ip_family", ipFamily)
+
+	if ipFamily != ipFamilyIPv6 && vnic.Spec.Ip.IpAddress != "" {
+		ipv4dict := make(map[string]interface{})
+		ipv4dict["dhcp"] = vnic.Spec.Ip.Dhcp
+		if !vnic.Spec.Ip.Dhcp {
+			ipv4dict["ip"] = vnic.Spec.Ip.IpAddress
+			ipv4dict["netmask"] = vnic.Spec.Ip.SubnetMask
+			if vnic.Spec.IpRouteSpec != nil {
+				ipv4dict["gw"] = vnic.Spec.IpRouteSpec.IpRouteConfig.GetHostIpRouteConfig().DefaultGateway
+			}
+		}
+		if err := d.Set("ipv4", []map[string]interface{}{ipv4dict}); err != nil {
+			return err
+		}
+	}
+
+	if ipFamily != ipFamilyIPv4 && vnic.Spec.Ip.IpV6Config != nil {
+		ipv6dict := map[string]interface{}{
+			"dhcp":       *vnic.Spec.Ip.IpV6Config.DhcpV6Enabled,
+			"autoconfig": *vnic.Spec.Ip.IpV6Config.AutoConfigurationEnabled,
+		}
+		addrList := make([]string, 0)
+		for _, addr := range vnic.Spec.Ip.IpV6Config.IpV6Address {
+			if addr.Origin == "manual" {
+				addrList = append(addrList, fmt.Sprintf("%s/%d", addr.IpAddress, addr.PrefixLength))
+			}
+		}
+		ipv6dict["addresses"] = addrList
+		if vnic.Spec.IpRouteSpec != nil {
+			ipv6dict["gw"] = vnic.Spec.IpRouteSpec.IpRouteConfig.GetHostIpRouteConfig().IpV6DefaultGateway
+		}
+		if err := d.Set("ipv6", []map[string]interface{}{ipv6dict}); err != nil {
+			return err
+		}
+	}
+
+	if vnic.Spec.NetStackInstanceKey == defaultTcpipStack {
+		services, err := getVnicServices(ctx, client, hostId, nicId)
+		if err != nil {
+			return err
+		}
+		_ = d.Set("services", services)
+	}
+
+	routes, err := getHostVnicRoutes(ctx, client, hostId, vnic.Spec.NetStackInstanceKey)
+	if err != nil {
+		return err
+	}
+	_ = d.Set("routes", routes)
+
+	dns, err := getVnicDNSConfig(ctx, client, hostId, vnic.Spec.NetStackInstanceKey)
+	if err != nil {
+		return err
+	}
+	if dns != nil {
+		_ = d.Set("dns", []map[string]interface{}{dns})
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/testhelper"
+)
+
+func TestAccDataSourceVSphereHostVNic_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccDataSourceVSphereHostVNicPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereHostVNicConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vsphere_host_vnic.vmk0", "id"),
+					resource.TestCheckResourceAttrSet("data.vsphere_host_vnic.vmk0", "mac"),
+					resource.TestCheckResourceAttrSet("data.vsphere_host_vnic.vmk0", "netstack"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereHostVNicPreCheck(t *testing.T) {
+	if os.Getenv("TF_VAR_VSPHERE_ESXI_HOST") == "" {
+		t.Skip("set TF_VAR_VSPHERE_ESXI_HOST to run vsphere_host_vnic data source acceptance tests")
+	}
+}
+
+func testAccDataSourceVSphereHostVNicConfig() string {
+	return fmt.Sprintf(`
+%s
+
+data "vsphere_host_vnic" "vmk0" {
+  host = "${data.vsphere_host.esxi_host.id}"
+  name = "vmk0"
+}
+`,
+		testhelper.CombineConfigs(testhelper.ConfigDataRootDC1(), testhelper.ConfigDataRootPortGroup1()),
+	)
+}
@@ -0,0 +1,277 @@
+package vsphere
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/datacenter"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func dataSourceVSphereNetwork() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereNetworkRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name or full path of the network.",
+			},
+			"datacenter_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The managed object ID of the datacenter the network is in. This can be omitted if the search path used for name is an absolute path.",
+			},
+			"distributed_virtual_switch_uuid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The UUID of the DVS the portgroup is associated with.",
+			},
+			"filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Additional criteria to disambiguate identically-named network backings on the same host or datacenter.",
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"network_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Description:  "Restrict the match to a specific backing type.",
+						ValidateFunc: validation.StringInSlice([]string{"Network", "DistributedVirtualPortgroup", "OpaqueNetwork"}, false),
+					},
+					"opaque_network_type": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Restrict the match to a specific NSX-T opaque network type, e.g. nsx.LogicalSwitch.",
+					},
+					"opaque_network_id": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Restrict the match to the opaque network with this ID.",
+					},
+				}},
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The managed object type of the discovered network.",
+			},
+			"opaque_network_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The NSX logical switch ID backing this network, set only when type is OpaqueNetwork.",
+			},
+			"opaque_network_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The NSX-T opaque network type (e.g. nsx.LogicalSwitch), set only when type is OpaqueNetwork.",
+			},
+			"dvs_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The UUID of the DVS this network belongs to, set only when type is DistributedVirtualPortgroup.",
+			},
+			"vlan_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The VLAN ID of this network, set only when the backing portgroup has one configured.",
+			},
+		},
+	}
+}
+
+func dataSourceVSphereNetworkRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*VSphereClient).vimClient
+	ctx := context.TODO()
+
+	name := d.Get("name").(string)
+
+	var dc *object.Datacenter
+	if v, ok := d.GetOk("datacenter_id"); ok {
+		var err error
+		dc, err = datacenter.FromID(client, v.(string))
+		if err != nil {
+			return fmt.Errorf("cannot locate datacenter: %s", err)
+		}
+	}
+
+	finder := find.NewFinder(client.Client, false)
+	if dc != nil {
+		finder.SetDatacenter(dc)
+	}
+
+	networks, err := finder.NetworkList(ctx, name)
+	if err != nil {
+		return fmt.Errorf("error fetching network list for %q: %s", name, err)
+	}
+	networks = filterNetworksByBackingType(networks)
+
+	if dvsUUID, ok := d.GetOk("distributed_virtual_switch_uuid"); ok {
+		networks, err = filterNetworksByDVSUuid(ctx, client, networks, dvsUUID.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	networks, err = filterNetworksByFilterBlock(ctx, client, networks, d)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(networks) < 1:
+		return fmt.Errorf("no matching network found for %q", name)
+	case len(networks) > 1:
+		return fmt.Errorf("%q matched %d networks, use the distributed_virtual_switch_uuid or filter arguments to disambiguate", name, len(networks))
+	}
+
+	net := networks[0]
+	d.SetId(net.Reference().Value)
+
+	return populateNetworkAttributes(ctx, client, net, d)
+}
+
+// filterNetworksByDVSUuid restricts a NetworkList result to distributed
+// portgroups that belong to the DVS with the given UUID, leaving standard
+// and opaque networks out of the result since they aren't DVS-backed.
+func filterNetworksByDVSUuid(ctx context.Context, client *govmomi.Client, nets []object.NetworkReference, dvsUUID string) ([]object.NetworkReference, error) {
+	out := make([]object.NetworkReference, 0, len(nets))
+	for _, n := range nets {
+		ref := n.Reference()
+		if ref.Type != "DistributedVirtualPortgroup" {
+			continue
+		}
+		uuid, err := distributedVirtualSwitchUuid(ctx, client, ref)
+		if err != nil {
+			return nil, err
+		}
+		if uuid == dvsUUID {
+			out = append(out, n)
+		}
+	}
+	return out, nil
+}
+
+// filterNetworksByFilterBlock applies the optional filter block's
+// network_type/opaque_network_type/opaque_network_id criteria.
+func filterNetworksByFilterBlock(ctx context.Context, client *govmomi.Client, nets []object.NetworkReference, d *schema.ResourceData) ([]object.NetworkReference, error) {
+	f, ok := d.GetOk("filter.0")
+	if !ok {
+		return nets, nil
+	}
+	filter := f.(map[string]interface{})
+	networkType := filter["network_type"].(string)
+	opaqueType := filter["opaque_network_type"].(string)
+	opaqueID := filter["opaque_network_id"].(string)
+
+	out := make([]object.NetworkReference, 0, len(nets))
+	for _, n := range nets {
+		ref := n.Reference()
+		if networkType != "" && ref.Type != networkType {
+			continue
+		}
+		if opaqueType == "" && opaqueID == "" {
+			out = append(out, n)
+			continue
+		}
+		if ref.Type != "OpaqueNetwork" {
+			continue
+		}
+		summary, err := opaqueNetworkSummary(ctx, client, ref)
+		if err != nil {
+			return nil, err
+		}
+		if opaqueType != "" && summary.OpaqueNetworkType != opaqueType {
+			continue
+		}
+		if opaqueID != "" && summary.OpaqueNetworkId != opaqueID {
+			continue
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+// filterNetworksByBackingType drops any NetworkReference whose backing type
+// isn't one this data source models. NetworkList can surface a distributed
+// switch itself alongside its portgroups; it isn't a network backing a VM
+// can attach to, so letting it through would resolve with none of the
+// portgroup/opaque attributes populated instead of erroring.
+func filterNetworksByBackingType(nets []object.NetworkReference) []object.NetworkReference {
+	out := make([]object.NetworkReference, 0, len(nets))
+	for _, n := range nets {
+		switch n.Reference().Type {
+		case "Network", "DistributedVirtualPortgroup", "OpaqueNetwork":
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
+func distributedVirtualSwitchUuid(ctx context.Context, client *govmomi.Client, ref types.ManagedObjectReference) (string, error) {
+	var pg mo.DistributedVirtualPortgroup
+	if err := property.DefaultCollector(client.Client).RetrieveOne(ctx, ref, []string{"config.distributedVirtualSwitch"}, &pg); err != nil {
+		return "", err
+	}
+	if pg.Config.DistributedVirtualSwitch == nil {
+		return "", nil
+	}
+	var dvs mo.DistributedVirtualSwitch
+	if err := property.DefaultCollector(client.Client).RetrieveOne(ctx, *pg.Config.DistributedVirtualSwitch, []string{"uuid"}, &dvs); err != nil {
+		return "", err
+	}
+	return dvs.Uuid, nil
+}
+
+func opaqueNetworkSummary(ctx context.Context, client *govmomi.Client, ref types.ManagedObjectReference) (*types.OpaqueNetworkSummary, error) {
+	var on mo.OpaqueNetwork
+	if err := property.DefaultCollector(client.Client).RetrieveOne(ctx, ref, []string{"summary"}, &on); err != nil {
+		return nil, err
+	}
+	summary, ok := on.Summary.(*types.OpaqueNetworkSummary)
+	if !ok {
+		return nil, fmt.Errorf("could not determine opaque network summary for %s", ref.Value)
+	}
+	return summary, nil
+}
+
+func populateNetworkAttributes(ctx context.Context, client *govmomi.Client, net object.NetworkReference, d *schema.ResourceData) error {
+	ref := net.Reference()
+	_ = d.Set("type", ref.Type)
+
+	switch ref.Type {
+	case "DistributedVirtualPortgroup":
+		var pg mo.DistributedVirtualPortgroup
+		if err := property.DefaultCollector(client.Client).RetrieveOne(ctx, ref, []string{"config"}, &pg); err != nil {
+			return err
+		}
+		if pg.Config.DistributedVirtualSwitch != nil {
+			var dvs mo.DistributedVirtualSwitch
+			if err := property.DefaultCollector(client.Client).RetrieveOne(ctx, *pg.Config.DistributedVirtualSwitch, []string{"uuid"}, &dvs); err != nil {
+				return err
+			}
+			_ = d.Set("dvs_uuid", dvs.Uuid)
+		}
+		if portSetting, ok := pg.Config.DefaultPortConfig.(*types.VMwareDVSPortSetting); ok {
+			if vlanSpec, ok := portSetting.Vlan.(*types.VmwareDistributedVirtualSwitchVlanIdSpec); ok {
+				_ = d.Set("vlan_id", vlanSpec.VlanId)
+			}
+		}
+	case "OpaqueNetwork":
+		summary, err := opaqueNetworkSummary(ctx, client, ref)
+		if err != nil {
+			return err
+		}
+		_ = d.Set("opaque_network_id", summary.OpaqueNetworkId)
+		_ = d.Set("opaque_network_type", summary.OpaqueNetworkType)
+	}
+
+	return nil
+}
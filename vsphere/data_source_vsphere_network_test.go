@@ -76,6 +76,56 @@ func TestAccDataSourceVSphereNetwork_hostPortgroups(t *testing.T) {
 	})
 }
 
+func TestAccDataSourceVSphereNetwork_opaqueNetwork(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccDataSourceVSphereNetworkOpaqueNetworkPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereNetworkConfigOpaqueNetwork(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vsphere_network.net", "type", "OpaqueNetwork"),
+					resource.TestCheckResourceAttrSet("data.vsphere_network.net", "opaque_network_id"),
+					resource.TestCheckResourceAttr("data.vsphere_network.net", "opaque_network_type", "nsx.LogicalSwitch"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereNetworkOpaqueNetworkPreCheck(t *testing.T) {
+	if os.Getenv("TF_VAR_VSPHERE_NSXT_OPAQUE_NETWORK") == "" {
+		t.Skip("set TF_VAR_VSPHERE_NSXT_OPAQUE_NETWORK to run vsphere_network opaque network acceptance tests")
+	}
+}
+
+func testAccDataSourceVSphereNetworkConfigOpaqueNetwork() string {
+	return fmt.Sprintf(`
+%s
+
+variable "opaque_network_name" {
+  default = "%s"
+}
+
+data "vsphere_network" "net" {
+  name          = "${var.opaque_network_name}"
+  datacenter_id = "${data.vsphere_datacenter.rootdc1.id}"
+
+  filter {
+    network_type        = "OpaqueNetwork"
+    opaque_network_type = "nsx.LogicalSwitch"
+  }
+}
+`,
+		testhelper.CombineConfigs(testhelper.ConfigDataRootDC1(), testhelper.ConfigDataRootPortGroup1()),
+		os.Getenv("TF_VAR_VSPHERE_NSXT_OPAQUE_NETWORK"),
+	)
+}
+
 func testAccDataSourceVSphereNetworkPreCheck(t *testing.T) {
 	if os.Getenv("TF_VAR_VSPHERE_PG_NAME") == "" {
 		t.Skip("set TF_VAR_VSPHERE_PG_NAME to run vsphere_network acceptance tests")
@@ -0,0 +1,239 @@
+package vsphere
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/datacenter"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/find"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+func dataSourceVSphereNetworks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVSphereNetworksRead,
+		Schema: map[string]*schema.Schema{
+			"datacenter_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The managed object ID of the datacenter to search. If omitted, the networks of the default datacenter are listed.",
+			},
+			"name_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A regular expression used to filter the returned networks by name.",
+			},
+			"name_glob": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A glob pattern used to filter the returned networks by name.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Restrict the result to a single network backing type.",
+				ValidateFunc: validation.StringInSlice([]string{"Network", "DistributedVirtualPortgroup", "OpaqueNetwork"}, false),
+			},
+			"distributed_virtual_switch_uuid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Restrict the result to portgroups belonging to the DVS with this UUID.",
+			},
+			"networks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The networks matching the search criteria.",
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The managed object ID of the network.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the network.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The managed object type of the network.",
+					},
+					"path": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The inventory path of the network.",
+					},
+					"vlan_id": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The VLAN ID of the network, set only when the backing portgroup has one configured.",
+					},
+					"dvs_uuid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The UUID of the DVS this network belongs to, set only when type is DistributedVirtualPortgroup.",
+					},
+				}},
+			},
+		},
+	}
+}
+
+func dataSourceVSphereNetworksRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*VSphereClient).vimClient
+	ctx := context.TODO()
+
+	var dc *object.Datacenter
+	if v, ok := d.GetOk("datacenter_id"); ok {
+		var err error
+		dc, err = datacenter.FromID(client, v.(string))
+		if err != nil {
+			return fmt.Errorf("cannot locate datacenter: %s", err)
+		}
+	}
+
+	finder := find.NewFinder(client.Client, false)
+	if dc != nil {
+		finder.SetDatacenter(dc)
+	}
+
+	// A single walk of the datacenter's network folder, rather than one
+	// Find per candidate name, keeps this efficient as the inventory grows.
+	all, err := finder.NetworkList(ctx, "*")
+	if err != nil {
+		return fmt.Errorf("error listing networks: %s", err)
+	}
+
+	if dvsUUID, ok := d.GetOk("distributed_virtual_switch_uuid"); ok {
+		all, err = filterNetworksByDVSUuid(ctx, client, all, dvsUUID.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex, err = regexp.Compile(v.(string))
+		if err != nil {
+			return fmt.Errorf("name_regex is not a valid regular expression: %s", err)
+		}
+	}
+	nameGlob := d.Get("name_glob").(string)
+	networkType := d.Get("type").(string)
+
+	results := make([]map[string]interface{}, 0, len(all))
+	for _, n := range all {
+		ref := n.Reference()
+		if networkType != "" && ref.Type != networkType {
+			continue
+		}
+
+		// NetworkList can surface the backing distributed switch itself
+		// alongside its portgroups; it isn't a network backing type this
+		// data source models, so skip it.
+		switch ref.Type {
+		case "Network", "DistributedVirtualPortgroup", "OpaqueNetwork":
+		default:
+			continue
+		}
+
+		invPath := networkInventoryPath(n)
+		name := invPath[strings.LastIndex(invPath, "/")+1:]
+
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			continue
+		}
+		if nameGlob != "" {
+			matched, err := filepath.Match(nameGlob, name)
+			if err != nil {
+				return fmt.Errorf("name_glob is not a valid glob pattern: %s", err)
+			}
+			if !matched {
+				continue
+			}
+		}
+
+		item := map[string]interface{}{
+			"id":   ref.Value,
+			"name": name,
+			"type": ref.Type,
+			"path": invPath,
+		}
+
+		if ref.Type == "DistributedVirtualPortgroup" {
+			uuid, vlanID, err := distributedPortgroupDetails(ctx, client, ref)
+			if err != nil {
+				return err
+			}
+			item["dvs_uuid"] = uuid
+			item["vlan_id"] = vlanID
+		}
+
+		results = append(results, item)
+	}
+
+	idParts := []string{
+		d.Get("datacenter_id").(string),
+		d.Get("name_regex").(string),
+		nameGlob,
+		networkType,
+		d.Get("distributed_virtual_switch_uuid").(string),
+	}
+	d.SetId(fmt.Sprintf("networks-%d", hashcode.String(strings.Join(idParts, "|"))))
+
+	return d.Set("networks", results)
+}
+
+// networkInventoryPath returns the inventory path finder already populated
+// on the object when it built the NetworkReference.
+func networkInventoryPath(n object.NetworkReference) string {
+	switch o := n.(type) {
+	case *object.Network:
+		return o.InventoryPath
+	case *object.DistributedVirtualPortgroup:
+		return o.InventoryPath
+	case *object.OpaqueNetwork:
+		return o.InventoryPath
+	default:
+		return ""
+	}
+}
+
+// distributedPortgroupDetails fetches the DVS UUID and VLAN ID for a
+// distributed portgroup: one property retrieval for the portgroup's config
+// and, when it belongs to a switch, a second one for the switch's UUID.
+func distributedPortgroupDetails(ctx context.Context, client *govmomi.Client, ref types.ManagedObjectReference) (string, int, error) {
+	var pg mo.DistributedVirtualPortgroup
+	if err := property.DefaultCollector(client.Client).RetrieveOne(ctx, ref, []string{"config"}, &pg); err != nil {
+		return "", 0, err
+	}
+
+	var uuid string
+	if pg.Config.DistributedVirtualSwitch != nil {
+		var dvs mo.DistributedVirtualSwitch
+		if err := property.DefaultCollector(client.Client).RetrieveOne(ctx, *pg.Config.DistributedVirtualSwitch, []string{"uuid"}, &dvs); err != nil {
+			return "", 0, err
+		}
+		uuid = dvs.Uuid
+	}
+
+	var vlanID int
+	if portSetting, ok := pg.Config.DefaultPortConfig.(*types.VMwareDVSPortSetting); ok {
+		if vlanSpec, ok := portSetting.Vlan.(*types.VmwareDistributedVirtualSwitchVlanIdSpec); ok {
+			vlanID = int(vlanSpec.VlanId)
+		}
+	}
+
+	return uuid, vlanID, nil
+}
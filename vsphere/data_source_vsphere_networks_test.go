@@ -0,0 +1,105 @@
+package vsphere
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/testhelper"
+)
+
+func TestAccDataSourceVSphereNetworks_dvsPortgroups(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccDataSourceVSphereNetworkPreCheck(t)
+			testAccSkipIfEsxi(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereNetworksConfigDVSPortgroups(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vsphere_networks.pgs", "networks.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceVSphereNetworks_hostPortgroups(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccDataSourceVSphereNetworkPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVSphereNetworksConfigHostPortgroups(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vsphere_networks.pgs", "networks.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVSphereNetworksConfigDVSPortgroups() string {
+	return fmt.Sprintf(`
+%s
+
+resource "vsphere_distributed_virtual_switch" "dvs" {
+  name          = "testacc-dvs"
+  datacenter_id = "${data.vsphere_datacenter.rootdc1.id}"
+}
+
+resource "vsphere_distributed_port_group" "pg" {
+  name                            = "terraform-test-pg"
+  distributed_virtual_switch_uuid = "${vsphere_distributed_virtual_switch.dvs.id}"
+}
+
+data "vsphere_networks" "pgs" {
+  datacenter_id                   = "${data.vsphere_datacenter.rootdc1.id}"
+  type                             = "DistributedVirtualPortgroup"
+  distributed_virtual_switch_uuid = "${vsphere_distributed_virtual_switch.dvs.id}"
+
+  depends_on = ["vsphere_distributed_port_group.pg"]
+}
+`,
+		testhelper.CombineConfigs(testhelper.ConfigDataRootDC1(), testhelper.ConfigDataRootPortGroup1()),
+	)
+}
+
+func testAccDataSourceVSphereNetworksConfigHostPortgroups() string {
+	return fmt.Sprintf(`
+%s
+
+resource "vsphere_host_virtual_switch" "switch" {
+  name           = "vSwitchTerraformTest"
+  host_system_id = "${data.vsphere_host.esxi_host.id}"
+
+  network_adapters = ["${var.host_nic0}", "${var.host_nic1}"]
+  active_nics      = ["${var.host_nic0}", "${var.host_nic1}"]
+  standby_nics     = []
+}
+
+resource "vsphere_host_port_group" "pg" {
+  name                = "PGTerraformTest"
+  host_system_id      = "${data.vsphere_host.esxi_host.id}"
+  virtual_switch_name = "${vsphere_host_virtual_switch.switch.name}"
+}
+
+data "vsphere_networks" "pgs" {
+  datacenter_id = "${data.vsphere_datacenter.rootdc1.id}"
+  name_glob     = "PGTerraformTest*"
+  type          = "Network"
+
+  depends_on = ["vsphere_host_port_group.pg"]
+}
+`,
+		testhelper.CombineConfigs(testhelper.ConfigDataRootDC1(), testhelper.ConfigDataRootPortGroup1()),
+	)
+}
@@ -0,0 +1,69 @@
+// Package debug provides the building blocks for turning on govmomi's SOAP
+// request/response tracing from the `debug`/`debug_path` provider arguments
+// or the VSPHERE_DEBUG/VSPHERE_DEBUG_PATH environment variables: resolving
+// the effective settings and registering the trace provider with govmomi.
+// Wiring this into provider configuration, so every resource and data
+// source benefits without per-resource changes, is left to the caller.
+package debug
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/vmware/govmomi/vim25/debug"
+)
+
+const (
+	// EnvDebug toggles tracing, overriding the provider's debug argument.
+	EnvDebug = "VSPHERE_DEBUG"
+	// EnvDebugPath overrides the provider's debug_path argument.
+	EnvDebugPath = "VSPHERE_DEBUG_PATH"
+)
+
+// ResolveSettings merges the provider schema's debug/debug_path arguments
+// with the VSPHERE_DEBUG/VSPHERE_DEBUG_PATH environment variables, with the
+// environment variable taking precedence so a trace can be forced on for a
+// single acceptance test run without editing provider config.
+func ResolveSettings(enabled bool, path string) (bool, string) {
+	if v := os.Getenv(EnvDebug); v != "" {
+		enabled = v == "1" || strings.EqualFold(v, "true")
+	}
+	if v := os.Getenv(EnvDebugPath); v != "" {
+		path = v
+	}
+	return enabled, path
+}
+
+// Enable registers a govmomi debug.FileProvider rooted at a timestamped
+// subdirectory of path, so every SOAP request/response for this provider run
+// is written out as XML under its own directory. It is a no-op, returning a
+// nil provider, if path is empty.
+func Enable(path string) (debug.Provider, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	run := filepath.Join(path, time.Now().Format("20060102-150405"))
+	if err := os.MkdirAll(run, 0700); err != nil {
+		return nil, fmt.Errorf("error creating debug trace directory %s: %s", run, err)
+	}
+
+	provider := &debug.FileProvider{Path: run}
+	debug.SetProvider(provider)
+	return provider, nil
+}
+
+// RedactURL returns a URL's string form with its userinfo (user name and
+// password) removed. The query string and fragment are kept as they are.
+func RedactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+	redacted := *u
+	redacted.User = nil
+	return redacted.String()
+}
@@ -4,19 +4,27 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/hostsystem"
 	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/structure"
 	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/vim25/methods"
 	"github.com/vmware/govmomi/vim25/mo"
 	"github.com/vmware/govmomi/vim25/types"
 )
 
 const (
 	defaultTcpipStack = "defaultTcpipStack"
+
+	ipFamilyIPv4 = "ipv4"
+	ipFamilyIPv6 = "ipv6"
+	ipFamilyDual = "dual"
 )
 
 func resourceVsphereNic() *schema.Resource {
@@ -66,9 +74,11 @@ func resourceVsphereNicRead(d *schema.ResourceData, meta interface{}) error {
 	_ = d.Set("mtu", vnic.Spec.Mtu)
 	_ = d.Set("mac", vnic.Spec.Mac)
 
+	ipFamily := d.Get("ip_family").(string)
+
 	// Do we have any ipv4 config ?
 	// IpAddress will be an empty string if ipv4 is off
-	if vnic.Spec.Ip.IpAddress != "" {
+	if ipFamily != ipFamilyIPv6 && vnic.Spec.Ip.IpAddress != "" {
 		// if DHCP is true then we should ignore whatever addresses are set here.
 		ipv4dict := make(map[string]interface{})
 		ipv4dict["dhcp"] = vnic.Spec.Ip.Dhcp
@@ -87,7 +97,7 @@ func resourceVsphereNicRead(d *schema.ResourceData, meta interface{}) error {
 
 	// Do we have any ipv6 config ?
 	// IpV6Config will be nil if ipv6 is off
-	if vnic.Spec.Ip.IpV6Config != nil {
+	if ipFamily != ipFamilyIPv4 && vnic.Spec.Ip.IpV6Config != nil {
 		ipv6dict := map[string]interface{}{
 			"dhcp":       *vnic.Spec.Ip.IpV6Config.DhcpV6Enabled,
 			"autoconfig": *vnic.Spec.Ip.IpV6Config.AutoConfigurationEnabled,
@@ -122,6 +132,20 @@ func resourceVsphereNicRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("services", services)
 	}
 
+	routes, err := getManagedVnicRoutes(ctx, client, hostId, vnic.Spec.NetStackInstanceKey, d.Get("routes").(*schema.Set))
+	if err != nil {
+		return err
+	}
+	_ = d.Set("routes", routes)
+
+	dns, err := getVnicDNSConfig(ctx, client, hostId, vnic.Spec.NetStackInstanceKey)
+	if err != nil {
+		return err
+	}
+	if dns != nil {
+		_ = d.Set("dns", []map[string]interface{}{dns})
+	}
+
 	return nil
 }
 
@@ -131,22 +155,47 @@ func resourceVsphereNicCreate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 	log.Printf("[DEBUG] Created NIC with ID: %s", nicId)
-	hostId := d.Get("host")
+	hostId := d.Get("host").(string)
 	tfNicID := fmt.Sprintf("%s_%s", hostId, nicId)
 	d.SetId(tfNicID)
+
+	if _, ok := d.GetOk("routes"); ok {
+		if err := syncVnicRoutes(d, meta, hostId, d.Get("netstack").(string)); err != nil {
+			return err
+		}
+	}
+
+	if _, ok := d.GetOk("dns.0"); ok {
+		if err := syncVnicDNSConfig(d, meta, hostId, d.Get("netstack").(string)); err != nil {
+			return err
+		}
+	}
+
 	return resourceVsphereNicRead(d, meta)
 }
 
 func resourceVsphereNicUpdate(d *schema.ResourceData, meta interface{}) error {
 	keys := []string{
 		"portgroup", "distributed_switch_port", "distributed_port_group",
-		"mac", "mtu", "ipv4", "ipv6", "netstack", "services"}
+		"mac", "mtu", "ip_family", "ipv4", "ipv6", "netstack", "services"}
 	if d.HasChanges(keys...) {
 		_, err := updateVNic(d, meta)
 		if err != nil {
 			return err
 		}
 	}
+	if d.HasChange("routes") {
+		hostId, _ := splitHostIdNicId(d)
+		if err := syncVnicRoutes(d, meta, hostId, d.Get("netstack").(string)); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("dns") {
+		hostId, _ := splitHostIdNicId(d)
+		if err := syncVnicDNSConfig(d, meta, hostId, d.Get("netstack").(string)); err != nil {
+			return err
+		}
+	}
 	return resourceVsphereNicRead(d, meta)
 }
 
@@ -154,6 +203,10 @@ func resourceVsphereNicDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*VSphereClient).vimClient
 	hostId, nicId := splitHostIdNicId(d)
 
+	if err := removeVnicRoutes(d, meta, hostId, d.Get("netstack").(string)); err != nil {
+		return err
+	}
+
 	err := removeVnic(client, hostId, nicId)
 	if err != nil {
 		return err
@@ -193,6 +246,13 @@ func BaseVMKernelSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Key of the distributed portgroup the nic will connect to",
 		},
+		"ip_family": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      ipFamilyDual,
+			Description:  "The IP family to enable on the interface. One of 'ipv4', 'ipv6', or 'dual'. Defaults to 'dual' for backwards compatibility.",
+			ValidateFunc: validation.StringInSlice([]string{ipFamilyIPv4, ipFamilyIPv6, ipFamilyDual}, false),
+		},
 		"ipv4": {
 			Type:     schema.TypeList,
 			Optional: true,
@@ -243,7 +303,12 @@ func BaseVMKernelSchema() map[string]*schema.Schema {
 						Type: schema.TypeString,
 					},
 					DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
-						return strings.EqualFold(old, new)
+						oldAddr, oldErr := canonicalizeIPv6CIDR(old)
+						newAddr, newErr := canonicalizeIPv6CIDR(new)
+						if oldErr != nil || newErr != nil {
+							return strings.EqualFold(old, new)
+						}
+						return oldAddr == newAddr
 					},
 				},
 				"gw": {
@@ -256,6 +321,41 @@ func BaseVMKernelSchema() map[string]*schema.Schema {
 				},
 			}},
 		},
+		"dns": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "DNS configuration for the netstack that owns this interface.",
+			Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				"hostname": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Hostname to set for this netstack.",
+				},
+				"domain": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Domain name to set for this netstack.",
+				},
+				"servers": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "DNS servers to use for this netstack.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"search": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "DNS search domains to use for this netstack.",
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"use_dhcp": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "Obtain DNS settings automatically via DHCP rather than the servers/search above.",
+				},
+			}},
+		},
 		"mac": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -283,10 +383,38 @@ func BaseVMKernelSchema() map[string]*schema.Schema {
 				Type: schema.TypeString,
 			},
 		},
+		"routes": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Static routes to add for the netstack that owns this interface.",
+			Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+				"destination": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Destination network address of the route, e.g. 10.20.0.0 or 2001:db8::.",
+				},
+				"prefix_length": {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Prefix length of the destination network.",
+				},
+				"gateway": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Gateway address through which the destination network is reachable.",
+				},
+			}},
+			Set: resourceVSphereNicRouteHash,
+		},
 	}
 	return sch
 }
 
+func resourceVSphereNicRouteHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(fmt.Sprintf("%s-%d-%s", m["destination"].(string), m["prefix_length"].(int), m["gateway"].(string)))
+}
+
 func updateVNic(d *schema.ResourceData, meta interface{}) (string, error) {
 	client := meta.(*VSphereClient).vimClient
 	hostId, nicId := splitHostIdNicId(d)
@@ -383,74 +511,98 @@ func getNicSpecFromSchema(d *schema.ResourceData) (*types.HostVirtualNicSpec, er
 		}
 	}
 
+	ipFamily := d.Get("ip_family").(string)
+
 	ipConfig := &types.HostIpConfig{}
 	routeConfig := &types.HostIpRouteConfig{} //routeConfig := r.IpRouteConfig.GetHostIpRouteConfig()
-	if ipv4, ok := d.GetOk("ipv4.0"); ok {
-		ipv4Config := ipv4.(map[string]interface{})
-
-		dhcp := ipv4Config["dhcp"].(bool)
-		ipv4Address := ipv4Config["ip"].(string)
-		ipv4Netmask := ipv4Config["netmask"].(string)
-		ipv4Gateway := ipv4Config["gw"].(string)
-
-		if dhcp {
-			ipConfig.Dhcp = dhcp
-		} else {
-			if ipv4Address != "" && ipv4Netmask != "" {
-				ipConfig.IpAddress = ipv4Address
-				ipConfig.SubnetMask = ipv4Netmask
-				routeConfig.DefaultGateway = ipv4Gateway
+	if ipFamily != ipFamilyIPv6 {
+		if ipv4, ok := d.GetOk("ipv4.0"); ok {
+			ipv4Config := ipv4.(map[string]interface{})
+
+			dhcp := ipv4Config["dhcp"].(bool)
+			ipv4Address := ipv4Config["ip"].(string)
+			ipv4Netmask := ipv4Config["netmask"].(string)
+			ipv4Gateway := ipv4Config["gw"].(string)
+
+			if dhcp {
+				ipConfig.Dhcp = dhcp
+			} else {
+				if ipv4Address != "" && ipv4Netmask != "" {
+					ipConfig.IpAddress = ipv4Address
+					ipConfig.SubnetMask = ipv4Netmask
+					routeConfig.DefaultGateway = ipv4Gateway
+				}
 			}
 		}
 	}
 
-	if ipv6, ok := d.GetOk("ipv6.0"); ok {
+	if ipFamily == ipFamilyIPv4 {
+		// Explicitly disable the IPv6 stack rather than leaving IpV6Config
+		// nil, which would preserve whatever ESXi already has configured.
+		disabled := false
+		ipv6Spec := &types.HostIpConfigIpV6AddressConfiguration{
+			DhcpV6Enabled:            &disabled,
+			AutoConfigurationEnabled: &disabled,
+		}
+
+		// Flipping to ipv4-only also has to remove any manually-assigned
+		// v6 addresses left over from a prior dual/ipv6 config, or they'd
+		// survive on the host invisibly once the ipv6 block stops being
+		// populated in Read.
+		oldAddrsIntf, _ := d.GetChange("ipv6.0.addresses")
+		oldAddrs, err := canonicalizeIPv6Addresses(oldAddrsIntf.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		if len(oldAddrs) > 0 {
+			addrs := make([]types.HostIpConfigIpV6Address, 0, len(oldAddrs))
+			for _, oldAddr := range oldAddrs {
+				tmpAddr, err := ipv6AddressFromCIDR(oldAddr.(string), "remove")
+				if err != nil {
+					return nil, err
+				}
+				addrs = append(addrs, tmpAddr)
+			}
+			ipv6Spec.IpV6Address = addrs
+		}
+
+		ipConfig.IpV6Config = ipv6Spec
+	} else if ipv6, ok := d.GetOk("ipv6.0"); ok {
 		ipv6Spec := &types.HostIpConfigIpV6AddressConfiguration{}
 		ipv6Config := ipv6.(map[string]interface{})
 
 		dhcpv6 := ipv6Config["dhcp"].(bool)
 		autoconfig := ipv6Config["autoconfig"].(bool)
-		//ipv6addrs := ipv6Config["addresses"].([]interface{})
 		ipv6Gateway := ipv6Config["gw"].(string)
 		ipv6Spec.DhcpV6Enabled = &dhcpv6
 		ipv6Spec.AutoConfigurationEnabled = &autoconfig
 
 		oldAddrsIntf, newAddrsIntf := d.GetChange("ipv6.0.addresses")
-		oldAddrs := oldAddrsIntf.([]interface{})
-		newAddrs := newAddrsIntf.([]interface{})
+		oldAddrs, err := canonicalizeIPv6Addresses(oldAddrsIntf.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		newAddrs, err := canonicalizeIPv6Addresses(newAddrsIntf.([]interface{}))
+		if err != nil {
+			return nil, err
+		}
 		removeAddrs := structure.DiffSlice(oldAddrs, newAddrs)
 		addAddrs := structure.DiffSlice(newAddrs, oldAddrs)
 
 		if len(removeAddrs) > 0 || len(addAddrs) > 0 {
-			addrs := make([]types.HostIpConfigIpV6Address, 0)
-			for _, oldAddr := range oldAddrs {
-				addrParts := strings.Split(oldAddr.(string), "/")
-				addr := addrParts[0]
-				prefix, err := strconv.ParseInt(addrParts[1], 0, 32)
+			addrs := make([]types.HostIpConfigIpV6Address, 0, len(removeAddrs)+len(addAddrs))
+			for _, oldAddr := range removeAddrs {
+				tmpAddr, err := ipv6AddressFromCIDR(oldAddr.(string), "remove")
 				if err != nil {
-					return nil, fmt.Errorf("error while parsing IPv6 address")
-				}
-				tmpAddr := types.HostIpConfigIpV6Address{
-					IpAddress:    strings.ToLower(addr),
-					PrefixLength: int32(prefix),
-					Origin:       "manual",
-					Operation:    "remove",
+					return nil, err
 				}
 				addrs = append(addrs, tmpAddr)
 			}
 
-			for _, newAddr := range newAddrs {
-				addrParts := strings.Split(newAddr.(string), "/")
-				addr := addrParts[0]
-				prefix, err := strconv.ParseInt(addrParts[1], 0, 32)
+			for _, newAddr := range addAddrs {
+				tmpAddr, err := ipv6AddressFromCIDR(newAddr.(string), "add")
 				if err != nil {
-					return nil, fmt.Errorf("error while parsing IPv6 address")
-				}
-				tmpAddr := types.HostIpConfigIpV6Address{
-					IpAddress:    strings.ToLower(addr),
-					PrefixLength: int32(prefix),
-					Origin:       "manual",
-					Operation:    "add",
+					return nil, err
 				}
 				addrs = append(addrs, tmpAddr)
 			}
@@ -577,6 +729,331 @@ func getVnicServices(ctx context.Context, client *govmomi.Client, hostId, nicId
 	return services, nil
 }
 
+// canonicalizeIPv6CIDR normalizes an "address/prefixLength" string to its
+// lowercase, zero-compressed form so that equivalent addresses (e.g.
+// "2001:DB8::1/64" and "2001:db8:0:0::1/64") compare equal.
+func canonicalizeIPv6CIDR(addr string) (string, error) {
+	addrParts := strings.SplitN(addr, "/", 2)
+	if len(addrParts) != 2 {
+		return "", fmt.Errorf("invalid IPv6 address %q, expected address/prefix_length", addr)
+	}
+	ip := net.ParseIP(addrParts[0])
+	if ip == nil {
+		return "", fmt.Errorf("invalid IPv6 address %q", addrParts[0])
+	}
+	prefix, err := strconv.ParseInt(addrParts[1], 0, 32)
+	if err != nil {
+		return "", fmt.Errorf("error while parsing IPv6 address %q: %s", addr, err)
+	}
+	return fmt.Sprintf("%s/%d", ip.String(), prefix), nil
+}
+
+// canonicalizeIPv6Addresses runs canonicalizeIPv6CIDR over a raw schema list.
+func canonicalizeIPv6Addresses(addrs []interface{}) ([]interface{}, error) {
+	out := make([]interface{}, 0, len(addrs))
+	for _, addr := range addrs {
+		canonical, err := canonicalizeIPv6CIDR(addr.(string))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, canonical)
+	}
+	return out, nil
+}
+
+// ipv6AddressFromCIDR builds the HostIpConfigIpV6Address entry for a single
+// manually configured address/prefix_length pair with the given operation
+// ("add" or "remove").
+func ipv6AddressFromCIDR(addr, operation string) (types.HostIpConfigIpV6Address, error) {
+	addrParts := strings.SplitN(addr, "/", 2)
+	if len(addrParts) != 2 {
+		return types.HostIpConfigIpV6Address{}, fmt.Errorf("invalid IPv6 address %q, expected address/prefix_length", addr)
+	}
+	prefix, err := strconv.ParseInt(addrParts[1], 0, 32)
+	if err != nil {
+		return types.HostIpConfigIpV6Address{}, fmt.Errorf("error while parsing IPv6 address %q: %s", addr, err)
+	}
+	return types.HostIpConfigIpV6Address{
+		IpAddress:    strings.ToLower(addrParts[0]),
+		PrefixLength: int32(prefix),
+		Origin:       "manual",
+		Operation:    operation,
+	}, nil
+}
+
+// syncVnicRoutes reconciles the routes block against the host's route table
+// for the netstack that owns this interface, adding and removing only the
+// entries that actually changed.
+func syncVnicRoutes(d *schema.ResourceData, meta interface{}, hostId, netStack string) error {
+	oldRoutes, newRoutes := d.GetChange("routes")
+	removeRoutes := oldRoutes.(*schema.Set).Difference(newRoutes.(*schema.Set)).List()
+	addRoutes := newRoutes.(*schema.Set).Difference(oldRoutes.(*schema.Set)).List()
+
+	if len(removeRoutes) == 0 && len(addRoutes) == 0 {
+		return nil
+	}
+
+	routeTable := &types.HostIpRouteTableConfig{}
+	for _, r := range removeRoutes {
+		appendRouteOp(routeTable, r.(map[string]interface{}), "remove")
+	}
+	for _, r := range addRoutes {
+		appendRouteOp(routeTable, r.(map[string]interface{}), "add")
+	}
+
+	return updateVnicRouteTable(meta.(*VSphereClient).vimClient, hostId, netStack, routeTable)
+}
+
+// removeVnicRoutes tears down every route Terraform added for this resource.
+func removeVnicRoutes(d *schema.ResourceData, meta interface{}, hostId, netStack string) error {
+	routes, ok := d.Get("routes").(*schema.Set)
+	if !ok || routes.Len() == 0 {
+		return nil
+	}
+
+	routeTable := &types.HostIpRouteTableConfig{}
+	for _, r := range routes.List() {
+		appendRouteOp(routeTable, r.(map[string]interface{}), "remove")
+	}
+
+	return updateVnicRouteTable(meta.(*VSphereClient).vimClient, hostId, netStack, routeTable)
+}
+
+// appendRouteOp adds a route change op to the v4 or v6 arm of table,
+// whichever matches the address family of the route's destination network.
+func appendRouteOp(table *types.HostIpRouteTableConfig, m map[string]interface{}, operation string) {
+	op := types.HostIpRouteOp{
+		ChangeOperation: operation,
+		Route: types.HostIpRouteEntry{
+			Network:      m["destination"].(string),
+			PrefixLength: int32(m["prefix_length"].(int)),
+			Gateway:      m["gateway"].(string),
+		},
+	}
+	if ip := net.ParseIP(m["destination"].(string)); ip != nil && ip.To4() == nil {
+		table.Ipv6Route = append(table.Ipv6Route, op)
+		return
+	}
+	table.IpRoute = append(table.IpRoute, op)
+}
+
+// updateVnicRouteTable pushes a route table config to the netstack that owns
+// this interface via HostNetworkSystem.UpdateNetworkConfig, the same entry
+// point syncVnicDNSConfig uses: the deprecated UpdateIpRouteTableConfig call
+// only ever targets the default netstack and can't scope to netStack.
+func updateVnicRouteTable(client *govmomi.Client, hostId, netStack string, routeTable *types.HostIpRouteTableConfig) error {
+	hns, err := hostNetworkSystemFromHostSystemID(client, hostId)
+	if err != nil {
+		return err
+	}
+
+	req := types.UpdateNetworkConfig{
+		This: hns.Reference(),
+		Config: types.HostNetworkConfig{
+			NetStackSpec: []types.HostNetworkConfigNetStackSpec{
+				{
+					Operation: "edit",
+					NetStackInstance: types.HostNetStackInstance{
+						Key:              netStack,
+						RouteTableConfig: routeTable,
+					},
+				},
+			},
+		},
+		ChangeMode: "modify",
+	}
+
+	if _, err := methods.UpdateNetworkConfig(context.TODO(), client.Client, &req); err != nil {
+		return fmt.Errorf("error updating route table for netstack %s: %s", netStack, err)
+	}
+	return nil
+}
+
+// netStackRouteEntries returns the live route table for the given netstack,
+// preferring its per-netstack entry (the same field syncVnicRoutes writes
+// through) and falling back to the deprecated host-wide RouteTableInfo only
+// for defaultTcpipStack, mirroring getVnicDNSConfig's DnsConfig lookup.
+func netStackRouteEntries(hostProps mo.HostSystem, netStack string) []types.HostIpRouteEntry {
+	for _, ns := range hostProps.Config.Network.NetStackInstance {
+		if ns.Key != netStack || ns.RouteTableConfig == nil {
+			continue
+		}
+		entries := append([]types.HostIpRouteEntry{}, ipRouteOpsToEntries(ns.RouteTableConfig.IpRoute)...)
+		return append(entries, ipRouteOpsToEntries(ns.RouteTableConfig.Ipv6Route)...)
+	}
+	if netStack != defaultTcpipStack || hostProps.Config.Network.RouteTableInfo == nil {
+		return nil
+	}
+	entries := append([]types.HostIpRouteEntry{}, hostProps.Config.Network.RouteTableInfo.IpRoute...)
+	return append(entries, hostProps.Config.Network.RouteTableInfo.Ipv6Route...)
+}
+
+// ipRouteOpsToEntries strips the change-operation wrapper HostIpRouteTableConfig
+// carries, since NetStackInstance.RouteTableConfig reuses that config type to
+// report the live table back rather than a dedicated read-only type.
+func ipRouteOpsToEntries(ops []types.HostIpRouteOp) []types.HostIpRouteEntry {
+	entries := make([]types.HostIpRouteEntry, 0, len(ops))
+	for _, op := range ops {
+		entries = append(entries, op.Route)
+	}
+	return entries
+}
+
+// getManagedVnicRoutes reads back the host's route table for the given
+// netstack and returns only the entries that match a route Terraform is
+// tracking in managed, so routes ESXi adds out-of-band (on-link, link-local)
+// are never adopted into state.
+func getManagedVnicRoutes(ctx context.Context, client *govmomi.Client, hostId, netStack string, managed *schema.Set) ([]map[string]interface{}, error) {
+	if managed == nil || managed.Len() == 0 {
+		return nil, nil
+	}
+
+	host, err := hostsystem.FromID(client, hostId)
+	if err != nil {
+		return nil, err
+	}
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), nil, &hostProps); err != nil {
+		return nil, err
+	}
+
+	liveRoutes := make(map[string]bool)
+	for _, route := range netStackRouteEntries(hostProps, netStack) {
+		liveRoutes[fmt.Sprintf("%s-%d-%s", route.Network, route.PrefixLength, route.Gateway)] = true
+	}
+
+	result := make([]map[string]interface{}, 0, managed.Len())
+	for _, r := range managed.List() {
+		route := r.(map[string]interface{})
+		key := fmt.Sprintf("%s-%d-%s", route["destination"].(string), route["prefix_length"].(int), route["gateway"].(string))
+		if liveRoutes[key] {
+			result = append(result, route)
+		}
+	}
+
+	return result, nil
+}
+
+// getHostVnicRoutes returns every route configured for the given netstack,
+// for callers like the vsphere_host_vnic data source that have no existing
+// routes config of their own to filter the live table against.
+func getHostVnicRoutes(ctx context.Context, client *govmomi.Client, hostId, netStack string) ([]map[string]interface{}, error) {
+	host, err := hostsystem.FromID(client, hostId)
+	if err != nil {
+		return nil, err
+	}
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), nil, &hostProps); err != nil {
+		return nil, err
+	}
+
+	entries := netStackRouteEntries(hostProps, netStack)
+	result := make([]map[string]interface{}, 0, len(entries))
+	for _, route := range entries {
+		result = append(result, map[string]interface{}{
+			"destination":   route.Network,
+			"prefix_length": int(route.PrefixLength),
+			"gateway":       route.Gateway,
+		})
+	}
+
+	return result, nil
+}
+
+// syncVnicDNSConfig pushes the dns block to the netstack that owns this
+// interface via HostNetworkSystem.UpdateNetworkConfig.
+func syncVnicDNSConfig(d *schema.ResourceData, meta interface{}, hostId, netStack string) error {
+	dns, ok := d.GetOk("dns.0")
+	if !ok {
+		return nil
+	}
+	dnsConfig := dns.(map[string]interface{})
+
+	cfg := &types.HostDnsConfig{
+		HostName:   dnsConfig["hostname"].(string),
+		DomainName: dnsConfig["domain"].(string),
+		Dhcp:       dnsConfig["use_dhcp"].(bool),
+	}
+	for _, s := range dnsConfig["servers"].([]interface{}) {
+		cfg.Address = append(cfg.Address, s.(string))
+	}
+	for _, s := range dnsConfig["search"].([]interface{}) {
+		cfg.SearchDomain = append(cfg.SearchDomain, s.(string))
+	}
+
+	client := meta.(*VSphereClient).vimClient
+	hns, err := hostNetworkSystemFromHostSystemID(client, hostId)
+	if err != nil {
+		return err
+	}
+
+	req := types.UpdateNetworkConfig{
+		This: hns.Reference(),
+		Config: types.HostNetworkConfig{
+			NetStackSpec: []types.HostNetworkConfigNetStackSpec{
+				{
+					Operation: "edit",
+					NetStackInstance: types.HostNetStackInstance{
+						Key:       netStack,
+						DnsConfig: cfg,
+					},
+				},
+			},
+		},
+		ChangeMode: "modify",
+	}
+
+	_, err = methods.UpdateNetworkConfig(context.TODO(), client.Client, &req)
+	if err != nil {
+		return fmt.Errorf("error updating DNS config for netstack %s: %s", netStack, err)
+	}
+	return nil
+}
+
+// getVnicDNSConfig reads back the DNS configuration for the netstack that
+// owns this interface, preferring the per-netstack entry so that vmotion and
+// defaultTcpipStack can carry distinct resolvers.
+func getVnicDNSConfig(ctx context.Context, client *govmomi.Client, hostId, netStack string) (map[string]interface{}, error) {
+	host, err := hostsystem.FromID(client, hostId)
+	if err != nil {
+		return nil, err
+	}
+
+	var hostProps mo.HostSystem
+	if err := host.Properties(ctx, host.Reference(), nil, &hostProps); err != nil {
+		return nil, err
+	}
+
+	var dnsConfig *types.HostDnsConfig
+	for _, ns := range hostProps.Config.Network.NetStackInstance {
+		if ns.Key == netStack && ns.DnsConfig != nil {
+			dnsConfig = ns.DnsConfig.GetHostDnsConfig()
+			break
+		}
+	}
+	if dnsConfig == nil && netStack == defaultTcpipStack && hostProps.Config.Network.DnsConfig != nil {
+		dnsConfig = hostProps.Config.Network.DnsConfig.GetHostDnsConfig()
+	}
+	if dnsConfig == nil {
+		return nil, nil
+	}
+
+	servers := make([]string, len(dnsConfig.Address))
+	copy(servers, dnsConfig.Address)
+	search := make([]string, len(dnsConfig.SearchDomain))
+	copy(search, dnsConfig.SearchDomain)
+
+	return map[string]interface{}{
+		"hostname": dnsConfig.HostName,
+		"domain":   dnsConfig.DomainName,
+		"servers":  servers,
+		"search":   search,
+		"use_dhcp": dnsConfig.Dhcp,
+	}, nil
+}
+
 func splitHostIdNicId(d *schema.ResourceData) (string, string) {
 	idParts := strings.Split(d.Id(), "_")
 	return idParts[0], idParts[1]
@@ -0,0 +1,116 @@
+package vsphere
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vsphere/vsphere/internal/helper/testhelper"
+)
+
+func TestAccResourceVSphereNic_dualStackRoutesAndDNS(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			RunSweepers()
+			testAccPreCheck(t)
+			testAccResourceVSphereNicPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceVSphereNicConfig("dual", []string{"2001:db8::10/64", "2001:db8::11/64"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vsphere_vnic.nic", "ip_family", "dual"),
+					resource.TestCheckResourceAttr("vsphere_vnic.nic", "ipv6.0.addresses.#", "2"),
+					resource.TestCheckResourceAttr("vsphere_vnic.nic", "dns.0.hostname", "terraform-test"),
+					resource.TestCheckResourceAttr("vsphere_vnic.nic", "routes.#", "1"),
+				),
+			},
+			{
+				// Dropping 2001:db8::10/64 and adding 2001:db8::12/64 exercises
+				// the add/remove reconciliation in getNicSpecFromSchema rather
+				// than a full-address-list replace.
+				Config: testAccResourceVSphereNicConfig("dual", []string{"2001:db8::11/64", "2001:db8::12/64"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vsphere_vnic.nic", "ipv6.0.addresses.#", "2"),
+				),
+			},
+			{
+				// Flipping to ipv4-only must also clean up the manually
+				// assigned v6 addresses left over from the steps above.
+				Config: testAccResourceVSphereNicConfig("ipv4", nil),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vsphere_vnic.nic", "ip_family", "ipv4"),
+					resource.TestCheckResourceAttr("vsphere_vnic.nic", "ipv6.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceVSphereNicPreCheck(t *testing.T) {
+	if os.Getenv("TF_VAR_VSPHERE_ESXI_HOST") == "" {
+		t.Skip("set TF_VAR_VSPHERE_ESXI_HOST to run vsphere_vnic acceptance tests")
+	}
+}
+
+func testAccResourceVSphereNicConfig(ipFamily string, ipv6Addresses []string) string {
+	ipv6Block := ""
+	if ipFamily != "ipv4" {
+		ipv6Block = fmt.Sprintf(`
+  ipv6 {
+    autoconfig = false
+    addresses  = ["%s"]
+  }
+`,
+			strings.Join(ipv6Addresses, `", "`),
+		)
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "vsphere_host_virtual_switch" "switch" {
+  name           = "vSwitchTerraformTest"
+  host_system_id = "${data.vsphere_host.esxi_host.id}"
+
+  network_adapters = ["${var.host_nic0}", "${var.host_nic1}"]
+  active_nics      = ["${var.host_nic0}", "${var.host_nic1}"]
+  standby_nics     = []
+}
+
+resource "vsphere_host_port_group" "pg" {
+  name                = "PGTerraformTest"
+  host_system_id      = "${data.vsphere_host.esxi_host.id}"
+  virtual_switch_name = "${vsphere_host_virtual_switch.switch.name}"
+}
+
+resource "vsphere_vnic" "nic" {
+  host      = "${data.vsphere_host.esxi_host.id}"
+  portgroup = "${vsphere_host_port_group.pg.name}"
+  ip_family = "%s"
+
+  ipv4 {
+    dhcp = true
+  }
+%s
+  dns {
+    hostname = "terraform-test"
+    domain   = "test.internal"
+    servers  = ["8.8.8.8"]
+  }
+
+  routes {
+    destination   = "10.20.0.0"
+    prefix_length = 16
+    gateway       = "192.168.100.1"
+  }
+}
+`,
+		testhelper.CombineConfigs(testhelper.ConfigDataRootDC1(), testhelper.ConfigDataRootPortGroup1()),
+		ipFamily,
+		ipv6Block,
+	)
+}